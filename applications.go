@@ -0,0 +1,44 @@
+package central
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CreateApplicationOption describes the fields accepted when creating an Application.
+type CreateApplicationOption struct {
+	Name           string `json:"name"`
+	WriteAccess    bool   `json:"write_access"`
+	OrganizationID int    `json:"organization_id"`
+}
+
+// EditApplicationOption describes the fields accepted when updating an Application.
+// Pointer fields are left unchanged when nil.
+type EditApplicationOption struct {
+	Name        *string `json:"name,omitempty"`
+	WriteAccess *bool   `json:"write_access,omitempty"`
+}
+
+// CreateApplication creates a new application.
+func (c *Client) CreateApplication(ctx context.Context, opt CreateApplicationOption) (*http.Response, *Application, error) {
+	var application Application
+	resp, err := c.doPOST(ctx, "/applications", "/applications", opt, &application)
+	if err != nil {
+		return resp, nil, err
+	}
+	// A prior lookup for this key may have been cached as not-found.
+	c.InvalidateApplication(ctx, application.Key)
+	return resp, &application, nil
+}
+
+// UpdateApplication updates the application with the given ID.
+func (c *Client) UpdateApplication(ctx context.Context, applicationID int, opt EditApplicationOption) (*http.Response, *Application, error) {
+	var application Application
+	resp, err := c.doPUT(ctx, fmt.Sprintf("/applications/%d", applicationID), "/applications/{id}", opt, &application)
+	if err != nil {
+		return resp, nil, err
+	}
+	c.InvalidateApplication(ctx, application.Key)
+	return resp, &application, nil
+}
@@ -0,0 +1,117 @@
+package central
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+type staticTokenSource struct {
+	token *oauth2.Token
+	err   error
+}
+
+func (s staticTokenSource) Token() (*oauth2.Token, error) {
+	return s.token, s.err
+}
+
+func TestSetAuthPrecedence(t *testing.T) {
+	tests := []struct {
+		name           string
+		client         Client
+		ctx            context.Context
+		wantAuthHeader string
+		wantCookie     string
+	}{
+		{
+			name: "token source takes precedence over everything",
+			client: Client{
+				tokenSource:   staticTokenSource{token: &oauth2.Token{AccessToken: "tok", TokenType: "Bearer"}},
+				bearerToken:   "static-bearer",
+				basicAuthUser: "user",
+				basicAuthPass: "pass",
+				sessionKey:    "default-session",
+			},
+			ctx:            context.Background(),
+			wantAuthHeader: "Bearer tok",
+		},
+		{
+			name: "bearer token takes precedence over basic auth and session",
+			client: Client{
+				bearerToken:   "static-bearer",
+				basicAuthUser: "user",
+				basicAuthPass: "pass",
+				sessionKey:    "default-session",
+			},
+			ctx:            context.Background(),
+			wantAuthHeader: "Bearer static-bearer",
+		},
+		{
+			name: "basic auth takes precedence over session",
+			client: Client{
+				basicAuthUser: "user",
+				basicAuthPass: "pass",
+				sessionKey:    "default-session",
+			},
+			ctx:            context.Background(),
+			wantAuthHeader: "Basic dXNlcjpwYXNz",
+		},
+		{
+			name: "falls back to the client's default session key",
+			client: Client{
+				sessionKey: "default-session",
+			},
+			ctx:        context.Background(),
+			wantCookie: "checkpoint.session=default-session",
+		},
+		{
+			name: "a per-request WithRequestSession override beats the default session key",
+			client: Client{
+				sessionKey: "default-session",
+			},
+			ctx:        WithRequestSession(context.Background(), "request-session"),
+			wantCookie: "checkpoint.session=request-session",
+		},
+		{
+			name:   "no auth configured sets neither header",
+			client: Client{},
+			ctx:    context.Background(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "http://example.test/", nil)
+			if err != nil {
+				t.Fatalf("failed to build request: %v", err)
+			}
+
+			c := tt.client
+			if err := c.setAuth(tt.ctx, req); err != nil {
+				t.Fatalf("setAuth returned error: %v", err)
+			}
+
+			if got := req.Header.Get("Authorization"); got != tt.wantAuthHeader {
+				t.Errorf("Authorization header = %q, want %q", got, tt.wantAuthHeader)
+			}
+			if got := req.Header.Get("Cookie"); got != tt.wantCookie {
+				t.Errorf("Cookie header = %q, want %q", got, tt.wantCookie)
+			}
+		})
+	}
+}
+
+func TestEffectiveSession(t *testing.T) {
+	c := &Client{sessionKey: "default-session"}
+
+	if got := c.effectiveSession(context.Background()); got != "default-session" {
+		t.Errorf("effectiveSession() with no override = %q, want %q", got, "default-session")
+	}
+
+	ctx := WithRequestSession(context.Background(), "request-session")
+	if got := c.effectiveSession(ctx); got != "request-session" {
+		t.Errorf("effectiveSession() with override = %q, want %q", got, "request-session")
+	}
+}
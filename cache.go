@@ -0,0 +1,183 @@
+package central
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// Cache is the pluggable backend behind WithCache. The default
+// implementation is an in-process LRU; callers that need a cache shared
+// across processes can substitute their own, e.g. a Redis-backed one.
+type Cache interface {
+	Get(key string) (value []byte, found bool)
+	Set(key string, value []byte, ttl time.Duration)
+	Delete(key string)
+}
+
+// cacheEnvelope lets a cache entry record a negative lookup (404) as
+// distinct from simply missing from the cache.
+type cacheEnvelope struct {
+	Found bool            `json:"found"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// WithCache wraps GetUserByIdentity, GetMembershipsByIdentity, and
+// GetApplicationByKey with an in-process LRU cache keyed by endpoint,
+// argument, and the effective session (see (*Client).effectiveSession).
+// Concurrent misses for the same key are coalesced with singleflight, and
+// 404s are cached under negativeTTL so a burst of lookups for a
+// nonexistent identity doesn't hammer Central.
+//
+// The session is folded into the cache key so that a Client combining
+// WithCache with per-request WithRequestSession overrides never serves one
+// caller's authorization-scoped response to a different session asking
+// for the same identity or key.
+func WithCache(ttl, negativeTTL time.Duration, maxEntries int) Option {
+	return func(c *Client) error {
+		cache, err := newLRUCache(maxEntries)
+		if err != nil {
+			return fmt.Errorf("failed to create cache: %w", err)
+		}
+		c.cache = cache
+		c.cacheTTL = ttl
+		c.cacheNegativeTTL = negativeTTL
+		return nil
+	}
+}
+
+// InvalidateUser evicts identityID's cached user for ctx's effective session
+// (see (*Client).effectiveSession), if caching is enabled. CreateUser,
+// UpdateUser and DeleteUser call this themselves after a successful write.
+//
+// Note this only evicts the entry for the session that performed the
+// write; other sessions' cached entries for the same identity expire on
+// their own TTL. The Cache interface only supports single-key deletion, so
+// a write-through invalidation can't reach across sessions without knowing
+// every session that has ever looked the identity up.
+func (c *Client) InvalidateUser(ctx context.Context, identityID int) {
+	c.cacheDelete(userCacheKey(identityID, c.effectiveSession(ctx)))
+}
+
+// InvalidateApplication evicts key's cached application for ctx's effective
+// session (see (*Client).effectiveSession), if caching is enabled.
+// CreateApplication and UpdateApplication call this themselves after a
+// successful write. Like InvalidateUser, this only evicts the writing
+// session's entry.
+func (c *Client) InvalidateApplication(ctx context.Context, key string) {
+	if key == "" {
+		return
+	}
+	c.cacheDelete(applicationCacheKey(key, c.effectiveSession(ctx)))
+}
+
+// Cache keys include the effective session (see (*Client).effectiveSession)
+// so that, when WithCache is combined with per-request WithRequestSession
+// overrides, one caller's authorization-scoped response for an ID is never
+// served to a different session asking for that same ID.
+
+func userCacheKey(identityID int, session string) string {
+	return fmt.Sprintf("user:by-identity:%d:session=%s", identityID, session)
+}
+
+func membershipsCacheKey(identityID int, session string) string {
+	return fmt.Sprintf("memberships:by-identity:%d:session=%s", identityID, session)
+}
+
+func applicationCacheKey(key string, session string) string {
+	return fmt.Sprintf("application:by-key:%s:session=%s", key, session)
+}
+
+// cacheGet reports whether key was found in the cache at all (hit) and,
+// if so, whether the cached lookup itself was positive (found). On a
+// positive hit, out is populated with the cached value.
+func (c *Client) cacheGet(key string, out interface{}) (found bool, hit bool) {
+	if c.cache == nil {
+		return false, false
+	}
+	raw, ok := c.cache.Get(key)
+	if !ok {
+		return false, false
+	}
+	var env cacheEnvelope
+	if err := json.Unmarshal(raw, &env); err != nil {
+		return false, false
+	}
+	if !env.Found {
+		return false, true
+	}
+	if err := json.Unmarshal(env.Value, out); err != nil {
+		return false, false
+	}
+	return true, true
+}
+
+func (c *Client) cacheSet(key string, found bool, value interface{}) {
+	if c.cache == nil {
+		return
+	}
+	env := cacheEnvelope{Found: found}
+	ttl := c.cacheNegativeTTL
+	if found {
+		ttl = c.cacheTTL
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return
+		}
+		env.Value = encoded
+	}
+	raw, err := json.Marshal(env)
+	if err != nil {
+		return
+	}
+	c.cache.Set(key, raw, ttl)
+}
+
+func (c *Client) cacheDelete(key string) {
+	if c.cache == nil {
+		return
+	}
+	c.cache.Delete(key)
+}
+
+// lruCache is the default in-process Cache, backed by a fixed-size LRU
+// with per-entry expiry.
+type lruCache struct {
+	entries *lru.Cache[string, lruCacheItem]
+}
+
+type lruCacheItem struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+func newLRUCache(maxEntries int) (*lruCache, error) {
+	entries, err := lru.New[string, lruCacheItem](maxEntries)
+	if err != nil {
+		return nil, err
+	}
+	return &lruCache{entries: entries}, nil
+}
+
+func (c *lruCache) Get(key string) ([]byte, bool) {
+	item, ok := c.entries.Get(key)
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(item.expiresAt) {
+		c.entries.Remove(key)
+		return nil, false
+	}
+	return item.value, true
+}
+
+func (c *lruCache) Set(key string, value []byte, ttl time.Duration) {
+	c.entries.Add(key, lruCacheItem{value: value, expiresAt: time.Now().Add(ttl)})
+}
+
+func (c *lruCache) Delete(key string) {
+	c.entries.Remove(key)
+}
@@ -1,14 +1,19 @@
 package central
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 	"time"
 
 	"github.com/jpillora/backoff"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/singleflight"
 )
 
 type Option func(c *Client) error
@@ -35,17 +40,30 @@ func WithSessionKey(s string) Option {
 }
 
 type Client struct {
-	hc         *http.Client
-	logger     *zap.SugaredLogger
-	url        url.URL
-	sessionKey string
+	hc               *http.Client
+	logger           *zap.SugaredLogger
+	url              url.URL
+	sessionKey       string
+	bearerToken      string
+	basicAuthUser    string
+	basicAuthPass    string
+	tokenSource      oauth2.TokenSource
+	retryPolicy      RetryPolicy
+	metrics          *metrics
+	tracer           trace.Tracer
+	cache            Cache
+	cacheTTL         time.Duration
+	cacheNegativeTTL time.Duration
+	sfGroup          *singleflight.Group
 }
 
 func Open(serviceURL url.URL, opts ...Option) (*Client, error) {
 	c := Client{
-		hc:     http.DefaultClient,
-		logger: zap.NewNop().Sugar(),
-		url:    serviceURL,
+		hc:          http.DefaultClient,
+		logger:      zap.NewNop().Sugar(),
+		url:         serviceURL,
+		retryPolicy: defaultRetryPolicy,
+		sfGroup:     &singleflight.Group{},
 	}
 	for _, opt := range opts {
 		if err := opt(&c); err != nil {
@@ -65,93 +83,321 @@ func (c *Client) WithOpts(opts ...Option) (*Client, error) {
 	return &newC, nil
 }
 
-func (c *Client) GetMembershipsByIdentity(ctx context.Context, identityID int) ([]Membership, error) {
+type membershipsResult struct {
+	resp        *http.Response
+	memberships []Membership
+}
+
+type userResult struct {
+	resp *http.Response
+	user *User
+}
+
+type applicationResult struct {
+	resp        *http.Response
+	application *Application
+}
+
+// GetMembershipsByIdentity returns the memberships for the given identity.
+//
+// The response is returned alongside the result so callers can inspect
+// status codes and headers without re-issuing the request. If WithCache
+// was configured, cached responses return a nil *http.Response since no
+// request was made.
+func (c *Client) GetMembershipsByIdentity(ctx context.Context, identityID int) (*http.Response, []Membership, error) {
+	if c.cache == nil {
+		return c.fetchMembershipsByIdentity(ctx, identityID)
+	}
+
+	key := membershipsCacheKey(identityID, c.effectiveSession(ctx))
+	var memberships []Membership
+	if found, hit := c.cacheGet(key, &memberships); hit {
+		if !found {
+			return nil, nil, nil
+		}
+		return nil, memberships, nil
+	}
+
+	v, err, _ := c.sfGroup.Do(key, func() (interface{}, error) {
+		resp, memberships, err := c.fetchMembershipsByIdentity(ctx, identityID)
+		if err != nil {
+			return membershipsResult{resp: resp}, err
+		}
+		c.cacheSet(key, memberships != nil, memberships)
+		return membershipsResult{resp, memberships}, nil
+	})
+	r := v.(membershipsResult)
+	if err != nil {
+		return r.resp, nil, err
+	}
+	return r.resp, r.memberships, nil
+}
+
+func (c *Client) fetchMembershipsByIdentity(ctx context.Context, identityID int) (*http.Response, []Membership, error) {
 	var memberships []Membership
-	_, err := c.doGET(ctx, fmt.Sprintf("/identities/%d/memberships", identityID), nil, &memberships)
+	resp, err := c.doGET(ctx, fmt.Sprintf("/identities/%d/memberships", identityID), "/identities/{id}/memberships", nil, &memberships)
 	if err != nil {
 		if isStatus(err, http.StatusNotFound) {
-			return nil, nil
+			return resp, nil, nil
 		}
-		return nil, err
+		return resp, nil, err
 	}
-	return memberships, nil
+	return resp, memberships, nil
 }
 
-func (c *Client) GetUserByIdentity(ctx context.Context, identityID int) (*User, error) {
+// GetUserByIdentity returns the user for the given identity.
+//
+// The response is returned alongside the result so callers can inspect
+// status codes and headers without re-issuing the request. If WithCache
+// was configured, cached responses return a nil *http.Response since no
+// request was made.
+func (c *Client) GetUserByIdentity(ctx context.Context, identityID int) (*http.Response, *User, error) {
+	if c.cache == nil {
+		return c.fetchUserByIdentity(ctx, identityID)
+	}
+
+	key := userCacheKey(identityID, c.effectiveSession(ctx))
 	var user User
-	_, err := c.doGET(ctx, fmt.Sprintf("/users/by-identity/%d", identityID), nil, &user)
+	if found, hit := c.cacheGet(key, &user); hit {
+		if !found {
+			return nil, nil, nil
+		}
+		return nil, &user, nil
+	}
+
+	v, err, _ := c.sfGroup.Do(key, func() (interface{}, error) {
+		resp, user, err := c.fetchUserByIdentity(ctx, identityID)
+		if err != nil {
+			return userResult{resp: resp}, err
+		}
+		c.cacheSet(key, user != nil, user)
+		return userResult{resp, user}, nil
+	})
+	r := v.(userResult)
+	if err != nil {
+		return r.resp, nil, err
+	}
+	return r.resp, r.user, nil
+}
+
+func (c *Client) fetchUserByIdentity(ctx context.Context, identityID int) (*http.Response, *User, error) {
+	var user User
+	resp, err := c.doGET(ctx, fmt.Sprintf("/users/by-identity/%d", identityID), "/users/by-identity/{id}", nil, &user)
 	if err != nil {
 		if isStatus(err, http.StatusNotFound) {
-			return nil, nil
+			return resp, nil, nil
 		}
-		return nil, err
+		return resp, nil, err
+	}
+	return resp, &user, nil
+}
+
+// GetApplicationByKey returns the application for the given key.
+//
+// The response is returned alongside the result so callers can inspect
+// status codes and headers without re-issuing the request. If WithCache
+// was configured, cached responses return a nil *http.Response since no
+// request was made.
+func (c *Client) GetApplicationByKey(ctx context.Context, key string) (*http.Response, *Application, error) {
+	if c.cache == nil {
+		return c.fetchApplicationByKey(ctx, key)
+	}
+
+	cacheKey := applicationCacheKey(key, c.effectiveSession(ctx))
+	var application Application
+	if found, hit := c.cacheGet(cacheKey, &application); hit {
+		if !found {
+			return nil, nil, nil
+		}
+		return nil, &application, nil
+	}
+
+	v, err, _ := c.sfGroup.Do(cacheKey, func() (interface{}, error) {
+		resp, application, err := c.fetchApplicationByKey(ctx, key)
+		if err != nil {
+			return applicationResult{resp: resp}, err
+		}
+		c.cacheSet(cacheKey, application != nil, application)
+		return applicationResult{resp, application}, nil
+	})
+	r := v.(applicationResult)
+	if err != nil {
+		return r.resp, nil, err
 	}
-	return &user, nil
+	return r.resp, r.application, nil
 }
 
-func (c *Client) GetApplicationByKey(ctx context.Context, key string) (*Application, error) {
+func (c *Client) fetchApplicationByKey(ctx context.Context, key string) (*http.Response, *Application, error) {
 	var application Application
-	_, err := c.doGET(ctx, fmt.Sprintf("/applications/keys/%s", key), nil, &application)
+	resp, err := c.doGET(ctx, fmt.Sprintf("/applications/keys/%s", key), "/applications/keys/{key}", nil, &application)
 	if err != nil {
 		if isStatus(err, http.StatusNotFound) {
-			return nil, nil
+			return resp, nil, nil
 		}
-		return nil, err
+		return resp, nil, err
 	}
-	return &application, nil
+	return resp, &application, nil
 }
 
 func (c *Client) doGET(
 	ctx context.Context,
 	path string,
+	route string,
 	params url.Values,
 	output interface{}) (*http.Response, error) {
-	req, err := c.newRequest(http.MethodGet, path, params)
-	if err != nil {
-		return nil, err
+	return c.do(ctx, http.MethodGet, path, route, params, nil, output)
+}
+
+func (c *Client) doPOST(
+	ctx context.Context,
+	path string,
+	route string,
+	body interface{},
+	output interface{}) (*http.Response, error) {
+	return c.do(ctx, http.MethodPost, path, route, nil, body, output)
+}
+
+func (c *Client) doPUT(
+	ctx context.Context,
+	path string,
+	route string,
+	body interface{},
+	output interface{}) (*http.Response, error) {
+	return c.do(ctx, http.MethodPut, path, route, nil, body, output)
+}
+
+func (c *Client) doDELETE(ctx context.Context, path string, route string) (*http.Response, error) {
+	return c.do(ctx, http.MethodDelete, path, route, nil, nil, nil)
+}
+
+func (c *Client) do(
+	ctx context.Context,
+	method string,
+	path string,
+	route string,
+	params url.Values,
+	body interface{},
+	output interface{}) (*http.Response, error) {
+	policy := c.retryPolicy
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
 	}
-	req = req.WithContext(ctx)
+	retryable := isIdempotentMethod(method)
+	bckoff := &backoff.Backoff{Min: policy.MinBackoff, Max: policy.MaxBackoff, Jitter: true}
+
+	for attempt := 1; ; attempt++ {
+		spanCtx, span, ownsSpan := c.startSpan(ctx, method, route, attempt)
+		stopInFlight := c.metrics.track(method, route)
+
+		req, err := c.newRequest(spanCtx, method, path, params, body)
+		if err != nil {
+			stopInFlight()
+			endSpan(span, ownsSpan, c.formatURL(path, params), 0, err)
+			return nil, err
+		}
+		req = req.WithContext(spanCtx)
 
-	bckoff := &backoff.Backoff{Jitter: true}
-	for {
 		startTime := time.Now()
 		resp, err := c.hc.Do(req)
+		duration := time.Since(startTime)
 		if err != nil {
-			return nil, fmt.Errorf("GET request to %s failed: %w", req.URL, err)
-		}
-		defer func() {
-			if resp.Body != nil {
-				_ = resp.Body.Close()
+			stopInFlight()
+			c.metrics.observe(method, route, 0, err, duration)
+			endSpan(span, ownsSpan, req.URL.String(), 0, err)
+			if retryable && attempt < policy.MaxAttempts && policy.RetryOn(nil, err) {
+				wait := bckoff.Duration()
+				c.logger.Warnw("request failed, will retry",
+					"attempt", attempt,
+					"backoff_ms", wait.Milliseconds(),
+					"error", err)
+				if !sleep(ctx, wait) {
+					return nil, ctx.Err()
+				}
+				continue
 			}
-		}()
+			return nil, fmt.Errorf("%s request to %s failed: %w", method, req.URL, err)
+		}
 
-		err, ok := c.checkResponse(req, resp, startTime)
+		checkErr, ok := c.checkResponse(req, resp, startTime)
+		stopInFlight()
+		c.metrics.observe(method, route, resp.StatusCode, checkErr, duration)
+		endSpan(span, ownsSpan, req.URL.String(), resp.StatusCode, checkErr)
 		if ok {
-			return resp, err
+			if retryable && attempt < policy.MaxAttempts && policy.RetryOn(resp, nil) {
+				wait := bckoff.Duration()
+				if d, hasRetryAfter := retryAfter(resp); hasRetryAfter {
+					wait = d
+				}
+				status := resp.StatusCode
+				drainAndClose(resp)
+				c.logger.Warnw("request returned a retryable status, will retry",
+					"attempt", attempt,
+					"backoff_ms", wait.Milliseconds(),
+					"status", status)
+				if !sleep(ctx, wait) {
+					return resp, ctx.Err()
+				}
+				continue
+			}
+			drainAndClose(resp)
+			return resp, checkErr
+		}
+
+		if output == nil {
+			drainAndClose(resp)
+			return resp, nil
 		}
 
 		err = decodeResponseAsJSON(resp, resp.Body, output)
 		if err != nil {
-			_ = resp.Body.Close()
-			c.logger.Warnf("Response error, will retry: %s", err)
-			time.Sleep(bckoff.Duration())
-			continue
+			drainAndClose(resp)
+			if retryable && attempt < policy.MaxAttempts {
+				wait := bckoff.Duration()
+				c.logger.Warnw("response decode failed, will retry",
+					"attempt", attempt,
+					"backoff_ms", wait.Milliseconds(),
+					"error", err)
+				if !sleep(ctx, wait) {
+					return resp, ctx.Err()
+				}
+				continue
+			}
+			return resp, err
 		}
+		drainAndClose(resp)
 		return resp, nil
 	}
 }
 
-func (c *Client) newRequest(method string, path string, params url.Values) (*http.Request, error) {
+func (c *Client) newRequest(ctx context.Context, method string, path string, params url.Values, body interface{}) (*http.Request, error) {
 	url := c.formatURL(path, params)
 
-	req, err := http.NewRequest(method, url, nil)
+	var bodyReader *bytes.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request body: %w", err)
+		}
+		bodyReader = bytes.NewReader(encoded)
+	}
+
+	var req *http.Request
+	var err error
+	if bodyReader != nil {
+		req, err = http.NewRequest(method, url, bodyReader)
+	} else {
+		req, err = http.NewRequest(method, url, nil)
+	}
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
+	if bodyReader != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
-	if k := c.sessionKey; k != "" {
-		req.Header.Set("Cookie", "checkpoint.session="+k)
+	if err := c.setAuth(ctx, req); err != nil {
+		return nil, err
 	}
 
 	return req, nil
@@ -166,6 +412,13 @@ func (c *Client) formatURL(path string, params url.Values) string {
 	return u.String()
 }
 
+// Each doGET/doPOST/doPUT/doDELETE call is passed an explicit route
+// template (e.g. "/applications/keys/{key}") alongside its interpolated
+// path. Metric and span labels are derived from the template rather than
+// the path so that free-form path segments like application keys don't
+// blow up cardinality; inferring the template from the path by pattern
+// alone can't tell a numeric ID from an arbitrary-cardinality string key.
+
 func (c *Client) checkResponse(
 	req *http.Request,
 	resp *http.Response,
@@ -0,0 +1,25 @@
+package central
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// ListOptions controls pagination for list endpoints.
+type ListOptions struct {
+	// Page is the 1-indexed page to fetch. Zero means the server default.
+	Page int
+	// PageSize is the number of results per page. Zero means the server default.
+	PageSize int
+}
+
+func (o ListOptions) queryValues() url.Values {
+	params := url.Values{}
+	if o.Page > 0 {
+		params.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PageSize > 0 {
+		params.Set("per_page", strconv.Itoa(o.PageSize))
+	}
+	return params
+}
@@ -0,0 +1,44 @@
+package central
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CreateMembershipOption describes the fields accepted when creating a Membership.
+type CreateMembershipOption struct {
+	UserID         int  `json:"user_id"`
+	OrganizationID int  `json:"organization_id"`
+	Role           Role `json:"role"`
+}
+
+// UpdateMembershipRoleOption describes the fields accepted when updating a Membership's role.
+type UpdateMembershipRoleOption struct {
+	Role Role `json:"role"`
+}
+
+// CreateMembership creates a new membership.
+func (c *Client) CreateMembership(ctx context.Context, opt CreateMembershipOption) (*http.Response, *Membership, error) {
+	var membership Membership
+	resp, err := c.doPOST(ctx, "/memberships", "/memberships", opt, &membership)
+	if err != nil {
+		return resp, nil, err
+	}
+	return resp, &membership, nil
+}
+
+// UpdateMembershipRole updates the role of an existing membership.
+func (c *Client) UpdateMembershipRole(ctx context.Context, membershipID int, opt UpdateMembershipRoleOption) (*http.Response, *Membership, error) {
+	var membership Membership
+	resp, err := c.doPUT(ctx, fmt.Sprintf("/memberships/%d", membershipID), "/memberships/{id}", opt, &membership)
+	if err != nil {
+		return resp, nil, err
+	}
+	return resp, &membership, nil
+}
+
+// DeleteMembership deletes the membership with the given ID.
+func (c *Client) DeleteMembership(ctx context.Context, membershipID int) (*http.Response, error) {
+	return c.doDELETE(ctx, fmt.Sprintf("/memberships/%d", membershipID), "/memberships/{id}")
+}
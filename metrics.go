@@ -0,0 +1,86 @@
+package central
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// metrics holds the Prometheus vectors registered by WithMetrics. A nil
+// *metrics is valid and every method on it is a no-op, so instrumentation
+// can be unconditionally threaded through do() whether or not it was
+// configured.
+type metrics struct {
+	requestsTotal   *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	inFlight        *prometheus.GaugeVec
+	errorsTotal     *prometheus.CounterVec
+}
+
+// WithMetrics registers request-count, latency, in-flight, and
+// error-by-status vectors on reg, labeled by method and route_template
+// (e.g. "/identities/{id}/memberships", not the interpolated path, so
+// cardinality stays bounded).
+func WithMetrics(reg prometheus.Registerer) Option {
+	return func(c *Client) error {
+		c.metrics = newMetrics(reg)
+		return nil
+	}
+}
+
+func newMetrics(reg prometheus.Registerer) *metrics {
+	labels := []string{"method", "route_template"}
+	m := &metrics{
+		requestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "central",
+			Name:      "requests_total",
+			Help:      "Total number of requests made to Central.",
+		}, labels),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "central",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of requests made to Central.",
+		}, labels),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "central",
+			Name:      "requests_in_flight",
+			Help:      "Number of requests to Central currently in flight.",
+		}, labels),
+		errorsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "central",
+			Name:      "request_errors_total",
+			Help:      "Total number of failed requests to Central.",
+		}, append(append([]string{}, labels...), "status")),
+	}
+	reg.MustRegister(m.requestsTotal, m.requestDuration, m.inFlight, m.errorsTotal)
+	return m
+}
+
+func (m *metrics) track(method, route string) func() {
+	if m == nil {
+		return func() {}
+	}
+	labels := prometheus.Labels{"method": method, "route_template": route}
+	m.inFlight.With(labels).Inc()
+	return func() {
+		m.inFlight.With(labels).Dec()
+	}
+}
+
+func (m *metrics) observe(method, route string, status int, err error, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	labels := prometheus.Labels{"method": method, "route_template": route}
+	m.requestsTotal.With(labels).Inc()
+	m.requestDuration.With(labels).Observe(duration.Seconds())
+	if err == nil && status < 400 {
+		return
+	}
+	statusLabel := "error"
+	if status > 0 {
+		statusLabel = strconv.Itoa(status)
+	}
+	m.errorsTotal.With(prometheus.Labels{"method": method, "route_template": route, "status": statusLabel}).Inc()
+}
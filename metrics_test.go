@@ -0,0 +1,55 @@
+package central
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// TestMetricsUseExplicitRouteTemplate checks that metrics are labeled by
+// the explicit template string callers pass (see do() in client.go), so
+// arbitrary-cardinality path segments like an application key are never
+// interpolated into a label.
+func TestMetricsUseExplicitRouteTemplate(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := newMetrics(reg)
+
+	stop := m.track("GET", "/applications/keys/{key}")
+	m.observe("GET", "/applications/keys/{key}", 200, nil, 0)
+	stop()
+
+	m.observe("GET", "/applications/keys/{key}", 404, nil, 0)
+
+	metricFamilies, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+
+	var requestsTotal *dto.MetricFamily
+	for _, mf := range metricFamilies {
+		if mf.GetName() == "central_requests_total" {
+			requestsTotal = mf
+		}
+	}
+	if requestsTotal == nil {
+		t.Fatal("central_requests_total metric family not found")
+	}
+	if len(requestsTotal.Metric) != 1 {
+		t.Fatalf("expected a single label combination for central_requests_total, got %d", len(requestsTotal.Metric))
+	}
+
+	metric := requestsTotal.Metric[0]
+	var gotRoute string
+	for _, l := range metric.Label {
+		if l.GetName() == "route_template" {
+			gotRoute = l.GetValue()
+		}
+	}
+	if gotRoute != "/applications/keys/{key}" {
+		t.Errorf("route_template label = %q, want the literal template, not an interpolated key", gotRoute)
+	}
+	if got := metric.Counter.GetValue(); got != 2 {
+		t.Errorf("central_requests_total = %v, want 2 (both calls share one label combination)", got)
+	}
+}
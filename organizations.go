@@ -0,0 +1,61 @@
+package central
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CreateOrganizationOption describes the fields accepted when creating an Organization.
+type CreateOrganizationOption struct {
+	Title    string `json:"title"`
+	ParentID *int   `json:"parent_id,omitempty"`
+	Realm    string `json:"realm"`
+}
+
+// EditOrganizationOption describes the fields accepted when updating an Organization.
+// Pointer fields are left unchanged when nil.
+type EditOrganizationOption struct {
+	Title *string `json:"title,omitempty"`
+	Realm *string `json:"realm,omitempty"`
+}
+
+// ListOrganizationsOption controls pagination for ListOrganizations.
+type ListOrganizationsOption struct {
+	ListOptions
+}
+
+// CreateOrganization creates a new organization.
+func (c *Client) CreateOrganization(ctx context.Context, opt CreateOrganizationOption) (*http.Response, *Organization, error) {
+	var organization Organization
+	resp, err := c.doPOST(ctx, "/organizations", "/organizations", opt, &organization)
+	if err != nil {
+		return resp, nil, err
+	}
+	return resp, &organization, nil
+}
+
+// UpdateOrganization updates the organization with the given ID.
+func (c *Client) UpdateOrganization(ctx context.Context, organizationID int, opt EditOrganizationOption) (*http.Response, *Organization, error) {
+	var organization Organization
+	resp, err := c.doPUT(ctx, fmt.Sprintf("/organizations/%d", organizationID), "/organizations/{id}", opt, &organization)
+	if err != nil {
+		return resp, nil, err
+	}
+	return resp, &organization, nil
+}
+
+// DeleteOrganization deletes the organization with the given ID.
+func (c *Client) DeleteOrganization(ctx context.Context, organizationID int) (*http.Response, error) {
+	return c.doDELETE(ctx, fmt.Sprintf("/organizations/%d", organizationID), "/organizations/{id}")
+}
+
+// ListOrganizations returns a page of organizations.
+func (c *Client) ListOrganizations(ctx context.Context, opt ListOrganizationsOption) (*http.Response, []Organization, error) {
+	var organizations []Organization
+	resp, err := c.doGET(ctx, "/organizations", "/organizations", opt.queryValues(), &organizations)
+	if err != nil {
+		return resp, nil, err
+	}
+	return resp, organizations, nil
+}
@@ -0,0 +1,112 @@
+package central
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the client retries failed requests.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is attempted,
+	// including the first try. Values less than 1 are treated as 1.
+	MaxAttempts int
+	// MinBackoff and MaxBackoff bound the delay between attempts.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// RetryOn decides whether a given response/error pair is retryable.
+	// It is only consulted for idempotent request methods (GET, HEAD,
+	// OPTIONS, PUT, DELETE); other methods are never retried.
+	RetryOn func(resp *http.Response, err error) bool
+}
+
+var defaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	MinBackoff:  100 * time.Millisecond,
+	MaxBackoff:  10 * time.Second,
+	RetryOn:     defaultRetryOn,
+}
+
+func defaultRetryOn(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	if resp == nil {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	}
+	return resp.StatusCode >= 500
+}
+
+// WithRetryPolicy overrides the client's default retry policy. A nil
+// retryOn falls back to defaultRetryOn rather than disabling retries.
+func WithRetryPolicy(maxAttempts int, min, max time.Duration, retryOn func(resp *http.Response, err error) bool) Option {
+	return func(c *Client) error {
+		if retryOn == nil {
+			retryOn = defaultRetryOn
+		}
+		c.retryPolicy = RetryPolicy{
+			MaxAttempts: maxAttempts,
+			MinBackoff:  min,
+			MaxBackoff:  max,
+			RetryOn:     retryOn,
+		}
+		return nil
+	}
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter parses the Retry-After header, supporting both the
+// delta-seconds and HTTP-date forms. The bool return is false when the
+// header is absent or unparseable.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// drainAndClose discards the remainder of the response body and closes it
+// so the underlying connection can be reused for the next attempt.
+func drainAndClose(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
+
+// sleep waits for d, returning false early if ctx is done first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,97 @@
+package central
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name      string
+		header    string
+		wantOK    bool
+		wantDelta time.Duration // only checked when wantOK and header is delta-seconds
+	}{
+		{
+			name:   "absent",
+			header: "",
+			wantOK: false,
+		},
+		{
+			name:      "delta seconds",
+			header:    "120",
+			wantOK:    true,
+			wantDelta: 120 * time.Second,
+		},
+		{
+			name:      "zero delta seconds",
+			header:    "0",
+			wantOK:    true,
+			wantDelta: 0,
+		},
+		{
+			name:   "future http date",
+			header: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat),
+			wantOK: true,
+		},
+		{
+			name:   "past http date",
+			header: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat),
+			wantOK: true,
+		},
+		{
+			name:   "garbage",
+			header: "not-a-duration-or-date",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+
+			d, ok := retryAfter(resp)
+			if ok != tt.wantOK {
+				t.Fatalf("retryAfter() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if tt.name == "past http date" {
+				if d != 0 {
+					t.Fatalf("retryAfter() for a past Retry-After date = %v, want 0", d)
+				}
+				return
+			}
+			if tt.name == "future http date" {
+				if d <= 0 {
+					t.Fatalf("retryAfter() for a future Retry-After date = %v, want > 0", d)
+				}
+				return
+			}
+			if d != tt.wantDelta {
+				t.Fatalf("retryAfter() = %v, want %v", d, tt.wantDelta)
+			}
+		})
+	}
+}
+
+func TestWithRetryPolicyNilRetryOnFallsBackToDefault(t *testing.T) {
+	c := &Client{}
+	opt := WithRetryPolicy(3, time.Millisecond, time.Second, nil)
+	if err := opt(c); err != nil {
+		t.Fatalf("WithRetryPolicy option returned error: %v", err)
+	}
+	if c.retryPolicy.RetryOn == nil {
+		t.Fatal("expected RetryOn to fall back to defaultRetryOn, got nil")
+	}
+
+	resp := &http.Response{StatusCode: http.StatusServiceUnavailable}
+	if !c.retryPolicy.RetryOn(resp, nil) {
+		t.Fatal("expected fallback RetryOn to treat 503 as retryable, like defaultRetryOn")
+	}
+}
@@ -0,0 +1,49 @@
+package central
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// WithTracer opens an OpenTelemetry client span around every request
+// attempt, recording the HTTP status code, URL, and retry count.
+func WithTracer(tp trace.TracerProvider) Option {
+	return func(c *Client) error {
+		c.tracer = tp.Tracer("go-central")
+		return nil
+	}
+}
+
+// startSpan opens a new span for this attempt only if WithTracer was
+// configured. owns reports whether the returned span was created here
+// (and so is this client's to end); when false the returned span is nil
+// and endSpan does nothing with it.
+func (c *Client) startSpan(ctx context.Context, method, route string, attempt int) (_ context.Context, span trace.Span, owns bool) {
+	if c.tracer == nil {
+		return ctx, nil, false
+	}
+	ctx, span = c.tracer.Start(ctx, method+" "+route)
+	span.SetAttributes(
+		attribute.String("http.method", method),
+		attribute.Int("retry.count", attempt-1),
+	)
+	return ctx, span, true
+}
+
+func endSpan(span trace.Span, owns bool, url string, statusCode int, err error) {
+	if !owns {
+		return
+	}
+	span.SetAttributes(attribute.String("http.url", url))
+	if statusCode > 0 {
+		span.SetAttributes(attribute.Int("http.status_code", statusCode))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
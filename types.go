@@ -25,9 +25,11 @@ type Organization struct {
 }
 
 type Application struct {
-	ID           int           `json:"id"`
-	Name         string        `json:"name"`
-	WriteAccess  bool          `json:"write_access"`
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	WriteAccess bool   `json:"write_access"`
+	// Key is the identifier GetApplicationByKey looks applications up by.
+	Key          string        `json:"key"`
 	CreatedAt    *time.Time    `json:"created_at"`
 	UpdatedAt    *time.Time    `json:"updated_at"`
 	Organization *Organization `json:"organization"`
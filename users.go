@@ -0,0 +1,69 @@
+package central
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CreateUserOption describes the fields accepted when creating a User.
+type CreateUserOption struct {
+	Name       string `json:"name"`
+	IdentityID int    `json:"identity_id"`
+	Admin      bool   `json:"admin"`
+}
+
+// EditUserOption describes the fields accepted when updating a User.
+// Pointer fields are left unchanged when nil.
+type EditUserOption struct {
+	Name  *string `json:"name,omitempty"`
+	Admin *bool   `json:"admin,omitempty"`
+}
+
+// ListUsersOption controls pagination for ListUsers.
+type ListUsersOption struct {
+	ListOptions
+}
+
+// CreateUser creates a new user.
+func (c *Client) CreateUser(ctx context.Context, opt CreateUserOption) (*http.Response, *User, error) {
+	var user User
+	resp, err := c.doPOST(ctx, "/users", "/users", opt, &user)
+	if err != nil {
+		return resp, nil, err
+	}
+	// A prior lookup for this identity may have been cached as not-found.
+	c.InvalidateUser(ctx, user.IdentityID)
+	return resp, &user, nil
+}
+
+// UpdateUser updates the user with the given identity ID.
+func (c *Client) UpdateUser(ctx context.Context, identityID int, opt EditUserOption) (*http.Response, *User, error) {
+	var user User
+	resp, err := c.doPUT(ctx, fmt.Sprintf("/users/by-identity/%d", identityID), "/users/by-identity/{id}", opt, &user)
+	if err != nil {
+		return resp, nil, err
+	}
+	c.InvalidateUser(ctx, identityID)
+	return resp, &user, nil
+}
+
+// DeleteUser deletes the user with the given identity ID.
+func (c *Client) DeleteUser(ctx context.Context, identityID int) (*http.Response, error) {
+	resp, err := c.doDELETE(ctx, fmt.Sprintf("/users/by-identity/%d", identityID), "/users/by-identity/{id}")
+	if err != nil {
+		return resp, err
+	}
+	c.InvalidateUser(ctx, identityID)
+	return resp, nil
+}
+
+// ListUsers returns a page of users.
+func (c *Client) ListUsers(ctx context.Context, opt ListUsersOption) (*http.Response, []User, error) {
+	var users []User
+	resp, err := c.doGET(ctx, "/users", "/users", opt.queryValues(), &users)
+	if err != nil {
+		return resp, nil, err
+	}
+	return resp, users, nil
+}